@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// staleStateAfter is how long the GUI can go without a tick before the
+// CLI assumes it has exited or hung, rather than just being between
+// timers.
+const staleStateAfter = 3 * time.Second
+
+// warnBelowRemaining is the remaining-time threshold below which the
+// status is flagged Warning regardless of focus hours.
+const warnBelowRemaining = 2 * time.Minute
+
+// statusLine is the i3blocks/waybar/polybar JSON contract: one line,
+// icon/state/text/full_text.
+type statusLine struct {
+	Icon     string `json:"icon"`
+	State    string `json:"state"`
+	Text     string `json:"text"`
+	FullText string `json:"full_text"`
+}
+
+// runStatusCLI implements the headless `TimeKiller status` /
+// `TimeKiller i3status` mode: it reads the state file the running GUI
+// process writes on every tick and prints a single status-bar JSON line,
+// without touching Fyne at all.
+func runStatusCLI() {
+	path, err := StatusStatePath()
+	if err != nil {
+		printStatusLine("Critical", "error")
+		return
+	}
+
+	state, err := ReadStatusState(path)
+	if err != nil {
+		printStatusLine("Critical", "error")
+		return
+	}
+
+	level, text := decideStatus(state, LoadFocusHours(), time.Now())
+	printStatusLine(level, text)
+}
+
+// decideStatus picks the Good/Warning/Critical level and display text
+// for an already-loaded state, given the focus hours config and the
+// current time. Split out from runStatusCLI so the decision itself is
+// testable without touching the filesystem.
+func decideStatus(state TimerStatus, focusHours FocusHoursConfig, now time.Time) (level, text string) {
+	if !state.Running {
+		return "Warning", "--:--:--"
+	}
+	if now.Sub(state.UpdatedAt) > staleStateAfter {
+		return "Critical", "stale"
+	}
+
+	level = "Good"
+	hour := now.Hour()
+	if hour < focusHours.StartHour || hour >= focusHours.EndHour {
+		level = "Warning"
+	}
+	if time.Duration(state.RemainingSeconds)*time.Second < warnBelowRemaining {
+		level = "Warning"
+	}
+	return level, state.Text
+}
+
+func printStatusLine(state, text string) {
+	line := statusLine{
+		Icon:     "time",
+		State:    state,
+		Text:     text,
+		FullText: fmt.Sprintf("TimeKiller: %s", text),
+	}
+	data, err := json.Marshal(line)
+	if err != nil {
+		fmt.Println(`{"icon":"time","state":"Critical","text":"error","full_text":"TimeKiller: error"}`)
+		return
+	}
+	fmt.Println(string(data))
+}
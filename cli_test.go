@@ -0,0 +1,49 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDecideStatusNotRunning(t *testing.T) {
+	level, text := decideStatus(TimerStatus{Running: false}, DefaultFocusHours(), time.Now())
+	if level != "Warning" || text != "--:--:--" {
+		t.Errorf("got (%q, %q), want (Warning, --:--:--)", level, text)
+	}
+}
+
+func TestDecideStatusStale(t *testing.T) {
+	now := time.Now()
+	state := TimerStatus{Running: true, UpdatedAt: now.Add(-10 * time.Second), Text: "00:05:00"}
+	level, _ := decideStatus(state, DefaultFocusHours(), now)
+	if level != "Critical" {
+		t.Errorf("level = %q, want Critical for a stale state file", level)
+	}
+}
+
+func TestDecideStatusGoodWithinFocusHours(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC) // 10:00, within 9-18
+	state := TimerStatus{Running: true, UpdatedAt: now, RemainingSeconds: 600, Text: "00:10:00"}
+	level, text := decideStatus(state, FocusHoursConfig{StartHour: 9, EndHour: 18}, now)
+	if level != "Good" || text != "00:10:00" {
+		t.Errorf("got (%q, %q), want (Good, 00:10:00)", level, text)
+	}
+}
+
+func TestDecideStatusWarningOutsideFocusHours(t *testing.T) {
+	now := time.Date(2026, 1, 1, 20, 0, 0, 0, time.UTC) // 20:00, outside 9-18
+	state := TimerStatus{Running: true, UpdatedAt: now, RemainingSeconds: 600, Text: "00:10:00"}
+	level, _ := decideStatus(state, FocusHoursConfig{StartHour: 9, EndHour: 18}, now)
+	if level != "Warning" {
+		t.Errorf("level = %q, want Warning outside focus hours", level)
+	}
+}
+
+func TestDecideStatusWarningLowRemaining(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	state := TimerStatus{Running: true, UpdatedAt: now, RemainingSeconds: 30, Text: "00:00:30"}
+	level, _ := decideStatus(state, FocusHoursConfig{StartHour: 9, EndHour: 18}, now)
+	if level != "Warning" {
+		t.Errorf("level = %q, want Warning with <2m remaining", level)
+	}
+}
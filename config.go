@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// FocusHoursConfig is the "focus hours" window used to pick Good vs.
+// Warning in the headless status output (see cli.go). It's plain JSON
+// rather than a Fyne preference because the headless CLI mode skips
+// Fyne entirely and still needs to read it.
+type FocusHoursConfig struct {
+	StartHour int `json:"start_hour"`
+	EndHour   int `json:"end_hour"`
+}
+
+// DefaultFocusHours is used whenever no config file exists yet.
+func DefaultFocusHours() FocusHoursConfig {
+	return FocusHoursConfig{StartHour: 9, EndHour: 18}
+}
+
+// ConfigPath returns ~/.config/timekiller/config.json (or the platform
+// equivalent of the user config dir).
+func ConfigPath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("find user config dir: %w", err)
+	}
+	return filepath.Join(configDir, "timekiller", "config.json"), nil
+}
+
+// LoadFocusHours reads the focus hours config, falling back to
+// DefaultFocusHours if it's missing or unreadable.
+func LoadFocusHours() FocusHoursConfig {
+	path, err := ConfigPath()
+	if err != nil {
+		return DefaultFocusHours()
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return DefaultFocusHours()
+	}
+	var cfg FocusHoursConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return DefaultFocusHours()
+	}
+	return cfg
+}
+
+// SaveFocusHours persists cfg so both the GUI and the headless CLI
+// agree on the focus hours window.
+func SaveFocusHours(cfg FocusHoursConfig) error {
+	path, err := ConfigPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create config dir: %w", err)
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("marshal config: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("write config: %w", err)
+	}
+	return nil
+}
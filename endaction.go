@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"fyne.io/fyne/v2"
+)
+
+// EndAction runs when a timer finishes. Several can be chained together
+// (e.g. Notify + Lock), so Run should not assume it's the only one.
+type EndAction interface {
+	// Name identifies the action for persistence in Fyne preferences and
+	// for display in the form's multi-select.
+	Name() string
+	Run() error
+}
+
+// endActionFactories lists the built-in, parameterless EndActions
+// offered in the form's multi-select, keyed by the Name() they persist
+// under.
+var endActionFactories = map[string]func() EndAction{
+	"Minimize All": func() EndAction { return minimizeAllAction{} },
+	"Lock":         func() EndAction { return lockAction{} },
+	"Sleep":        func() EndAction { return sleepAction{} },
+	"Shutdown":     func() EndAction { return shutdownAction{} },
+}
+
+// EndActionNames returns the built-in action names in a stable order,
+// suitable for populating the form's multi-select.
+func EndActionNames() []string {
+	return []string{"Minimize All", "Lock", "Sleep", "Shutdown", "Notify"}
+}
+
+// ResolveEndAction looks up a parameterless built-in action (see
+// endActionFactories) by the name it was persisted under. "Notify" is
+// not among them, so it reports false for that name.
+func ResolveEndAction(name string) (EndAction, bool) {
+	factory, ok := endActionFactories[name]
+	if !ok {
+		return nil, false
+	}
+	return factory(), true
+}
+
+type minimizeAllAction struct{}
+
+func (minimizeAllAction) Name() string { return "Minimize All" }
+func (minimizeAllAction) Run() error   { return minimizeAllWindowsOS() }
+
+type lockAction struct{}
+
+func (lockAction) Name() string { return "Lock" }
+func (lockAction) Run() error   { return lockWorkstationOS() }
+
+type sleepAction struct{}
+
+func (sleepAction) Name() string { return "Sleep" }
+func (sleepAction) Run() error   { return sleepSystemOS() }
+
+type shutdownAction struct{}
+
+func (shutdownAction) Name() string { return "Shutdown" }
+func (shutdownAction) Run() error   { return shutdownSystemOS() }
+
+// RunCommandAction runs an arbitrary shell command through `sh -c`,
+// e.g. to trigger a custom script when a timer ends.
+type RunCommandAction struct {
+	Cmd string
+}
+
+func (a RunCommandAction) Name() string { return "Run Command: " + a.Cmd }
+
+func (a RunCommandAction) Run() error {
+	if strings.TrimSpace(a.Cmd) == "" {
+		return fmt.Errorf("run command action has no command configured")
+	}
+	cmd := exec.Command("sh", "-c", a.Cmd)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("run command %q: %w", a.Cmd, err)
+	}
+	return nil
+}
+
+// NotifyAction shows a desktop notification via Fyne's own notification
+// support, so the timer is visible even when no other end action is
+// configured.
+type NotifyAction struct {
+	App     fyne.App
+	Title   string
+	Content string
+}
+
+func (a NotifyAction) Name() string { return "Notify" }
+
+func (a NotifyAction) Run() error {
+	a.App.SendNotification(fyne.NewNotification(a.Title, a.Content))
+	return nil
+}
@@ -0,0 +1,23 @@
+//go:build darwin
+
+package main
+
+import "os/exec"
+
+// minimizeAllWindowsOS sends a plain "d" keystroke (no modifier keys)
+// through System Events via AppleScript.
+func minimizeAllWindowsOS() error {
+	return exec.Command("osascript", "-e", `tell application "System Events" to keystroke "d"`).Run()
+}
+
+func lockWorkstationOS() error {
+	return exec.Command("pmset", "displaysleepnow").Run()
+}
+
+func sleepSystemOS() error {
+	return exec.Command("pmset", "sleepnow").Run()
+}
+
+func shutdownSystemOS() error {
+	return exec.Command("osascript", "-e", `tell application "System Events" to shut down`).Run()
+}
@@ -0,0 +1,27 @@
+//go:build linux
+
+package main
+
+import "os/exec"
+
+// minimizeAllWindowsOS prefers wmctrl's "show desktop" mode, falling
+// back to simulating the Super+D shortcut via xdotool if wmctrl isn't
+// installed.
+func minimizeAllWindowsOS() error {
+	if err := exec.Command("wmctrl", "-k", "on").Run(); err == nil {
+		return nil
+	}
+	return exec.Command("xdotool", "key", "super+d").Run()
+}
+
+func lockWorkstationOS() error {
+	return exec.Command("loginctl", "lock-session").Run()
+}
+
+func sleepSystemOS() error {
+	return exec.Command("systemctl", "suspend").Run()
+}
+
+func shutdownSystemOS() error {
+	return exec.Command("systemctl", "poweroff").Run()
+}
@@ -0,0 +1,23 @@
+//go:build windows
+
+package main
+
+import "os/exec"
+
+// minimizeAllWindowsOS minimizes every window by running PowerShell to
+// call MinimizeAll on the Shell.Application COM object.
+func minimizeAllWindowsOS() error {
+	return exec.Command("powershell", "-Command", "(New-Object -ComObject Shell.Application).MinimizeAll()").Run()
+}
+
+func lockWorkstationOS() error {
+	return exec.Command("rundll32.exe", "user32.dll,LockWorkStation").Run()
+}
+
+func sleepSystemOS() error {
+	return exec.Command("rundll32.exe", "powrprof.dll,SetSuspendState", "0,1,0").Run()
+}
+
+func shutdownSystemOS() error {
+	return exec.Command("shutdown", "/s", "/t", "0").Run()
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+
+	"golang.design/x/hotkey"
+)
+
+// registerGlobalHotkeys binds OS-level hotkeys so the timer can be
+// started/stopped/paused from any application, not just while the
+// TimeKiller window has focus. Each hotkey runs its own listener
+// goroutine for the lifetime of the process.
+func registerGlobalHotkeys(g *GUI) {
+	bind(hotkey.New([]hotkey.Modifier{hotkey.ModCtrl, hotkey.ModShift}, hotkey.KeyS), g.startTimer)
+	bind(hotkey.New([]hotkey.Modifier{hotkey.ModCtrl, hotkey.ModShift}, hotkey.KeyX), g.stopTimer)
+	bind(hotkey.New([]hotkey.Modifier{hotkey.ModCtrl, hotkey.ModShift}, hotkey.KeyP), g.togglePause)
+}
+
+// bind registers hk and calls action each time it fires, logging (but
+// not failing startup on) registration errors, since global hotkeys can
+// be unavailable in sandboxed or headless environments.
+func bind(hk *hotkey.Hotkey, action func()) {
+	if err := hk.Register(); err != nil {
+		fmt.Println("Error registering global hotkey:", err)
+		return
+	}
+	go func() {
+		for range hk.Keydown() {
+			action()
+		}
+	}()
+}
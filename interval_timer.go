@@ -0,0 +1,284 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// TimerPhase identifies which part of a Pomodoro-style cycle is running.
+type TimerPhase int
+
+const (
+	PhaseWork TimerPhase = iota
+	PhaseShortBreak
+	PhaseLongBreak
+)
+
+func (p TimerPhase) String() string {
+	switch p {
+	case PhaseWork:
+		return "Work"
+	case PhaseShortBreak:
+		return "Short Break"
+	case PhaseLongBreak:
+		return "Long Break"
+	default:
+		return "Unknown"
+	}
+}
+
+// PhaseChange describes the state of an IntervalTimer at the moment it
+// transitions into a new phase.
+type PhaseChange struct {
+	Phase       TimerPhase
+	Cycle       int
+	TotalCycles int
+}
+
+// IntervalConfig configures an IntervalTimer's work/break cycle.
+type IntervalConfig struct {
+	WorkDuration          time.Duration
+	ShortBreak            time.Duration
+	LongBreak             time.Duration
+	CyclesBeforeLongBreak int
+	TotalCycles           int
+}
+
+// IntervalTimer implements TimerService as a Pomodoro-style sequence of
+// work and break phases, automatically advancing from one to the next
+// until TotalCycles work phases have completed.
+type IntervalTimer struct {
+	config IntervalConfig
+
+	// OnPhaseChange, if set, is called whenever the timer moves into a
+	// new phase (including the very first one, from Start).
+	OnPhaseChange func(PhaseChange)
+
+	mu              sync.Mutex
+	phase           TimerPhase
+	cycle           int
+	endTime         time.Time
+	timer           *time.Timer
+	stopped         bool
+	paused          bool
+	pausedRemaining time.Duration
+	done            chan struct{}
+	// timerReplaced is signaled whenever timer is swapped for a new one
+	// (resume, AddDuration) so run's select picks up the new timer.C.
+	timerReplaced chan struct{}
+}
+
+// NewIntervalTimer builds an IntervalTimer ready to Start. Cycle counts
+// default to 4 work cycles before a long break and 8 total cycles if
+// left at zero.
+func NewIntervalTimer(cfg IntervalConfig) *IntervalTimer {
+	if cfg.CyclesBeforeLongBreak <= 0 {
+		cfg.CyclesBeforeLongBreak = 4
+	}
+	if cfg.TotalCycles <= 0 {
+		cfg.TotalCycles = 8
+	}
+	return &IntervalTimer{
+		config:        cfg,
+		stopped:       true,
+		done:          make(chan struct{}),
+		timerReplaced: make(chan struct{}, 1),
+	}
+}
+
+func (it *IntervalTimer) Start() {
+	it.mu.Lock()
+	if !it.stopped {
+		it.mu.Unlock()
+		return
+	}
+	it.stopped = false
+	it.cycle = 1
+	it.phase = PhaseWork
+	it.mu.Unlock()
+
+	it.enterPhase(it.phaseDuration(PhaseWork))
+	go it.run()
+}
+
+// run advances phases as each one's timer fires, until TotalCycles work
+// phases have completed or Stop is called.
+func (it *IntervalTimer) run() {
+	for {
+		it.mu.Lock()
+		timer := it.timer
+		it.mu.Unlock()
+		if timer == nil {
+			return
+		}
+
+		select {
+		case <-it.done:
+			return
+		case <-it.timerReplaced:
+			continue // Resume/AddDuration swapped the timer; pick up the new one
+		case <-timer.C:
+			if it.advance() {
+				return
+			}
+		}
+	}
+}
+
+// advance moves to the next phase, reporting it via OnPhaseChange. It
+// returns true once the session is complete.
+//
+// On natural completion it deliberately does NOT close it.done itself:
+// that channel is only for a caller-driven Stop(), and closing it here
+// would let the session finish without ever routing through the GUI's
+// normal completion path (recordSession/runEndActions/etc., the same
+// path a RealTimer's expiry uses). Instead it just marks the timer
+// stopped; GetRemainingTime then reports 0 and the GUI's poll loop picks
+// that up on its next tick, same as any other "time's up".
+func (it *IntervalTimer) advance() bool {
+	it.mu.Lock()
+	switch it.phase {
+	case PhaseWork:
+		if it.cycle >= it.config.TotalCycles {
+			it.stopped = true
+			it.mu.Unlock()
+			return true
+		}
+		if it.cycle%it.config.CyclesBeforeLongBreak == 0 {
+			it.phase = PhaseLongBreak
+		} else {
+			it.phase = PhaseShortBreak
+		}
+	default:
+		it.cycle++
+		it.phase = PhaseWork
+	}
+	phase := it.phase
+	it.mu.Unlock()
+
+	it.enterPhase(it.phaseDuration(phase))
+	return false
+}
+
+func (it *IntervalTimer) enterPhase(d time.Duration) {
+	it.mu.Lock()
+	it.endTime = time.Now().Add(d)
+	it.timer = time.NewTimer(d)
+	phase, cycle, total := it.phase, it.cycle, it.config.TotalCycles
+	it.mu.Unlock()
+
+	if it.OnPhaseChange != nil {
+		it.OnPhaseChange(PhaseChange{Phase: phase, Cycle: cycle, TotalCycles: total})
+	}
+}
+
+func (it *IntervalTimer) phaseDuration(phase TimerPhase) time.Duration {
+	switch phase {
+	case PhaseWork:
+		return it.config.WorkDuration
+	case PhaseShortBreak:
+		return it.config.ShortBreak
+	default:
+		return it.config.LongBreak
+	}
+}
+
+func (it *IntervalTimer) Stop() {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	if it.stopped {
+		return
+	}
+	if it.timer != nil {
+		it.timer.Stop()
+	}
+	it.stopped = true
+	close(it.done)
+}
+
+func (it *IntervalTimer) GetRemainingTime() time.Duration {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	if it.stopped {
+		return 0
+	}
+	if it.paused {
+		return it.pausedRemaining
+	}
+	return time.Until(it.endTime)
+}
+
+func (it *IntervalTimer) Pause() {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	if it.stopped || it.paused {
+		return
+	}
+	it.timer.Stop()
+	it.pausedRemaining = time.Until(it.endTime)
+	it.paused = true
+}
+
+func (it *IntervalTimer) Resume() {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	if it.stopped || !it.paused {
+		return
+	}
+	it.endTime = time.Now().Add(it.pausedRemaining)
+	it.timer = time.NewTimer(it.pausedRemaining)
+	it.paused = false
+	it.signalTimerReplaced()
+}
+
+// AddDuration shifts the current phase's remaining time by d.
+func (it *IntervalTimer) AddDuration(d time.Duration) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	if it.stopped {
+		return
+	}
+	if it.paused {
+		it.pausedRemaining += d
+		if it.pausedRemaining < 0 {
+			it.pausedRemaining = 0
+		}
+		return
+	}
+	it.endTime = it.endTime.Add(d)
+	remaining := time.Until(it.endTime)
+	if remaining < 0 {
+		remaining = 0
+	}
+	it.timer.Stop()
+	it.timer = time.NewTimer(remaining)
+	it.signalTimerReplaced()
+}
+
+// signalTimerReplaced wakes up run's select so it re-reads it.timer.
+// Callers must hold it.mu.
+func (it *IntervalTimer) signalTimerReplaced() {
+	select {
+	case it.timerReplaced <- struct{}{}:
+	default:
+	}
+}
+
+func (it *IntervalTimer) GetEndTime() time.Time {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	return it.endTime
+}
+
+func (it *IntervalTimer) Done() <-chan struct{} {
+	return it.done
+}
+
+// CurrentPhase reports the phase IntervalTimer is in right now, for
+// callers (e.g. the GUI) that want to render the initial Session panel
+// before the first phase transition occurs.
+func (it *IntervalTimer) CurrentPhase() PhaseChange {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	return PhaseChange{Phase: it.phase, Cycle: it.cycle, TotalCycles: it.config.TotalCycles}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIntervalTimerPhaseSequence(t *testing.T) {
+	it := NewIntervalTimer(IntervalConfig{
+		WorkDuration:          10 * time.Millisecond,
+		ShortBreak:            10 * time.Millisecond,
+		LongBreak:             10 * time.Millisecond,
+		CyclesBeforeLongBreak: 2,
+		TotalCycles:           3,
+	})
+
+	var phases []TimerPhase
+	changes := make(chan PhaseChange, 16)
+	it.OnPhaseChange = func(p PhaseChange) { changes <- p }
+
+	it.Start()
+	defer it.Stop()
+
+	// Work(1) -> ShortBreak -> Work(2) -> LongBreak -> Work(3) -> done.
+	want := []TimerPhase{PhaseWork, PhaseShortBreak, PhaseWork, PhaseLongBreak, PhaseWork}
+	for range want {
+		select {
+		case p := <-changes:
+			phases = append(phases, p.Phase)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for phase change, got %v so far", phases)
+		}
+	}
+
+	for i, p := range want {
+		if phases[i] != p {
+			t.Errorf("phase[%d] = %v, want %v (full sequence: %v)", i, phases[i], p, phases)
+		}
+	}
+}
+
+func TestIntervalTimerCompletesWithoutClosingDoneEarly(t *testing.T) {
+	it := NewIntervalTimer(IntervalConfig{
+		WorkDuration:          5 * time.Millisecond,
+		ShortBreak:            5 * time.Millisecond,
+		LongBreak:             5 * time.Millisecond,
+		CyclesBeforeLongBreak: 4,
+		TotalCycles:           1,
+	})
+	it.Start()
+
+	// Give the single work cycle time to finish naturally.
+	time.Sleep(50 * time.Millisecond)
+
+	if it.GetRemainingTime() != 0 {
+		t.Errorf("GetRemainingTime() = %v after natural completion, want 0", it.GetRemainingTime())
+	}
+
+	select {
+	case <-it.Done():
+		t.Fatal("Done() closed on natural completion; expected it to stay open until an explicit Stop()")
+	default:
+	}
+}
+
+func TestIntervalTimerPauseResume(t *testing.T) {
+	it := NewIntervalTimer(IntervalConfig{
+		WorkDuration: time.Hour,
+		TotalCycles:  1,
+	})
+	it.Start()
+	defer it.Stop()
+
+	before := it.GetRemainingTime()
+	it.Pause()
+	time.Sleep(20 * time.Millisecond)
+	paused := it.GetRemainingTime()
+	if paused > before || paused < before-time.Second {
+		t.Errorf("GetRemainingTime() drifted while paused: before=%v paused=%v", before, paused)
+	}
+
+	it.Resume()
+	if it.GetRemainingTime() <= 0 {
+		t.Error("GetRemainingTime() <= 0 after Resume()")
+	}
+}
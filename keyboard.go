@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+// adjustStep is how much +/- nudges a running timer by.
+const adjustStep = 5 * time.Minute
+
+// attachKeyboard wires single-key commands onto the window. Fyne only
+// invokes these canvas-level handlers when the currently focused widget
+// doesn't consume the event itself (a focused widget.Entry swallows
+// typed runes), so these commands are naturally "when no entry is
+// focused" as required.
+func (g *GUI) attachKeyboard() {
+	g.window.Canvas().SetOnTypedKey(g.handleTypedKey)
+	g.window.Canvas().SetOnTypedRune(g.handleTypedRune)
+}
+
+func (g *GUI) handleTypedKey(key *fyne.KeyEvent) {
+	switch key.Name {
+	case fyne.KeyEscape:
+		g.window.Close()
+	case fyne.KeyReturn:
+		g.startTimer()
+	}
+}
+
+// handleTypedRune dispatches the single-key command scheme:
+//
+//	s       start
+//	x       stop
+//	p       pause/resume
+//	+ / -   add/subtract 5 minutes from the running timer
+//	1-9     start a preset N-minute timer
+//	r       restart with the last used duration
+//
+// Anything else falls back to the previous behavior of refocusing the
+// minutes entry.
+func (g *GUI) handleTypedRune(r rune) {
+	switch {
+	case r == 's':
+		g.startTimer()
+	case r == 'x':
+		g.stopTimer()
+	case r == 'p':
+		g.togglePause()
+	case r == '+':
+		g.nudgeRunning(adjustStep)
+	case r == '-':
+		g.nudgeRunning(-adjustStep)
+	case r >= '1' && r <= '9':
+		g.startPresetMinutes(int(r - '0'))
+	case r == 'r':
+		g.restartLast()
+	default:
+		g.window.Canvas().Focus(g.minutesEntry)
+	}
+}
+
+func (g *GUI) togglePause() {
+	service := g.getTimerService()
+	if service == nil {
+		return
+	}
+	paused := g.isPaused()
+	if paused {
+		service.Resume()
+	} else {
+		service.Pause()
+	}
+	g.setPaused(!paused)
+}
+
+func (g *GUI) nudgeRunning(d time.Duration) {
+	service := g.getTimerService()
+	if service == nil {
+		return
+	}
+	service.AddDuration(d)
+}
+
+// startPresetMinutes starts a fixed N-minute countdown directly,
+// independent of whether Interval mode is checked — the single-key
+// presets are documented as plain countdowns, not Pomodoro sessions.
+func (g *GUI) startPresetMinutes(minutes int) {
+	g.minutesEntry.SetText(strconv.Itoa(minutes))
+	g.startCountdown(time.Duration(minutes) * time.Minute)
+}
+
+// restartLast restarts the last plain countdown duration directly, for
+// the same reason startPresetMinutes bypasses startTimer.
+func (g *GUI) restartLast() {
+	d := g.getLastDuration()
+	if d <= 0 {
+		return
+	}
+	g.minutesEntry.SetText(fmt.Sprintf("%g", d.Minutes()))
+	g.startCountdown(d)
+}
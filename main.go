@@ -3,12 +3,16 @@ package main
 import (
 	"errors"
 	"fmt"
-	"os/exec"
+	"os"
+	"sort"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/app"
+	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/dialog"
 	"fyne.io/fyne/v2/theme"
@@ -21,15 +25,28 @@ type TimerService interface {
 	Stop()
 	GetRemainingTime() time.Duration
 	GetEndTime() time.Time
+	// Done returns a channel that is closed when Stop ends a timer that is
+	// not yet stopped, letting callers stop polling without type-asserting
+	// the concrete type. Stop on an already-stopped timer (including an
+	// IntervalTimer whose session has completed) leaves it open.
+	Done() <-chan struct{}
+	Pause()
+	Resume()
+	// AddDuration shifts the current phase's remaining time by d, which
+	// may be negative. It is a no-op on a stopped timer.
+	AddDuration(d time.Duration)
 }
 
 // RealTimer implements TimerService
 type RealTimer struct {
-	duration time.Duration
-	endTime  time.Time
-	timer    *time.Timer
-	stopped  bool
-	done     chan struct{}
+	mu              sync.Mutex
+	duration        time.Duration
+	endTime         time.Time
+	timer           *time.Timer
+	stopped         bool
+	paused          bool
+	pausedRemaining time.Duration
+	done            chan struct{}
 }
 
 func NewRealTimer(duration time.Duration) *RealTimer {
@@ -41,6 +58,8 @@ func NewRealTimer(duration time.Duration) *RealTimer {
 }
 
 func (rt *RealTimer) Start() {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
 	if rt.stopped {
 		rt.endTime = time.Now().Add(rt.duration)
 		rt.timer = time.NewTimer(rt.duration)
@@ -49,6 +68,8 @@ func (rt *RealTimer) Start() {
 }
 
 func (rt *RealTimer) Stop() {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
 	if !rt.stopped {
 		rt.timer.Stop()
 		rt.stopped = true
@@ -57,16 +78,71 @@ func (rt *RealTimer) Stop() {
 }
 
 func (rt *RealTimer) GetRemainingTime() time.Duration {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
 	if rt.stopped {
 		return 0
 	}
+	if rt.paused {
+		return rt.pausedRemaining
+	}
 	return time.Until(rt.endTime)
 }
 
 func (rt *RealTimer) GetEndTime() time.Time {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
 	return rt.endTime
 }
 
+func (rt *RealTimer) Done() <-chan struct{} {
+	return rt.done
+}
+
+func (rt *RealTimer) Pause() {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	if rt.stopped || rt.paused {
+		return
+	}
+	rt.timer.Stop()
+	rt.pausedRemaining = time.Until(rt.endTime)
+	rt.paused = true
+}
+
+func (rt *RealTimer) Resume() {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	if rt.stopped || !rt.paused {
+		return
+	}
+	rt.endTime = time.Now().Add(rt.pausedRemaining)
+	rt.timer = time.NewTimer(rt.pausedRemaining)
+	rt.paused = false
+}
+
+func (rt *RealTimer) AddDuration(d time.Duration) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	if rt.stopped {
+		return
+	}
+	if rt.paused {
+		rt.pausedRemaining += d
+		if rt.pausedRemaining < 0 {
+			rt.pausedRemaining = 0
+		}
+		return
+	}
+	rt.endTime = rt.endTime.Add(d)
+	remaining := time.Until(rt.endTime)
+	if remaining < 0 {
+		remaining = 0
+	}
+	rt.timer.Stop()
+	rt.timer = time.NewTimer(remaining)
+}
+
 // GUI struct to manage the UI
 type GUI struct {
 	app            fyne.App
@@ -79,12 +155,68 @@ type GUI struct {
 	endTimeLabel   *widget.Label
 	startButton    *widget.Button
 	stopButton     *widget.Button
+
+	// Pomodoro / interval-session mode
+	intervalCheck    *widget.Check
+	workMinutes      *widget.Entry
+	shortBreakMins   *widget.Entry
+	longBreakMins    *widget.Entry
+	cyclesBeforeLong *widget.Entry
+	totalCycles      *widget.Entry
+	sessionLabel     *canvas.Text
+
+	// History. currentStart is guarded by sessionMu (see below).
+	// historyRows is rebuilt off the UI thread (recordSession can run
+	// from runTicker's goroutine), while the widget.List callbacks read
+	// it on the UI thread, so it's guarded by historyMu instead.
+	store        TimerStore
+	currentStart time.Time
+	historyList  *widget.List
+	historyMu    sync.Mutex
+	historyRows  []string
+
+	// End-of-timer actions
+	endActionSelect *widget.CheckGroup
+	customCmdEntry  *widget.Entry
+
+	// Focus hours, shared with the headless status CLI via config.go
+	focusStartEntry *widget.Entry
+	focusEndEntry   *widget.Entry
+
+	// Keyboard control. paused/lastDuration/currentStart/timerService are
+	// read and written from the UI thread, the in-window keyboard
+	// dispatcher, and the global hotkey goroutines (see hotkeys.go), so
+	// they're guarded by sessionMu rather than left as plain fields.
+	sessionMu    sync.Mutex
+	paused       bool
+	lastDuration time.Duration
+
+	// Status state shared with the headless CLI (see cli.go)
+	statePath string
+
+	// running guards against starting a second timer (and a second
+	// runTicker goroutine) while one is already in flight — reachable
+	// from the UI thread, the keyboard dispatcher, and the global
+	// hotkey goroutines, so it's a CAS rather than a plain bool.
+	running int32
 }
 
-func NewGUI(app fyne.App, timerService TimerService) *GUI {
+// historyRounding is the granularity daily totals in the History tab are
+// rounded to.
+const historyRounding = 15 * time.Minute
+
+// Fyne preference keys for the end-of-timer action configuration.
+const (
+	prefEndActions       = "endActions"
+	prefEndActionCommand = "endActionCommand"
+)
+
+func NewGUI(app fyne.App, timerService TimerService, store TimerStore, statePath string) *GUI {
 	return &GUI{
 		app:          app,
 		timerService: timerService,
+		store:        store,
+		statePath:    statePath,
 	}
 }
 
@@ -144,35 +276,89 @@ func (g *GUI) createUI() {
 	g.stopButton = widget.NewButton("Stop", g.stopTimer)
 	g.stopButton.Disable()
 
+	g.intervalCheck = widget.NewCheck("Pomodoro mode", nil)
+
+	g.workMinutes = widget.NewEntry()
+	g.workMinutes.SetPlaceHolder("25")
+	g.shortBreakMins = widget.NewEntry()
+	g.shortBreakMins.SetPlaceHolder("5")
+	g.longBreakMins = widget.NewEntry()
+	g.longBreakMins.SetPlaceHolder("15")
+	g.cyclesBeforeLong = widget.NewEntry()
+	g.cyclesBeforeLong.SetPlaceHolder("4")
+	g.totalCycles = widget.NewEntry()
+	g.totalCycles.SetPlaceHolder("8")
+
+	g.sessionLabel = canvas.NewText("", theme.ForegroundColor())
+	g.sessionLabel.TextStyle = fyne.TextStyle{Bold: true}
+	g.sessionLabel.Hide()
+
+	g.endActionSelect = widget.NewCheckGroup(EndActionNames(), func(selected []string) {
+		g.app.Preferences().SetStringList(prefEndActions, selected)
+	})
+	g.endActionSelect.SetSelected(g.app.Preferences().StringListWithFallback(prefEndActions, []string{"Minimize All"}))
+
+	g.customCmdEntry = widget.NewEntry()
+	g.customCmdEntry.SetPlaceHolder("Custom command (optional)")
+	g.customCmdEntry.Text = g.app.Preferences().String(prefEndActionCommand)
+	g.customCmdEntry.OnChanged = func(text string) {
+		g.app.Preferences().SetString(prefEndActionCommand, text)
+	}
+
+	focusHours := LoadFocusHours()
+	g.focusStartEntry = widget.NewEntry()
+	g.focusStartEntry.SetText(strconv.Itoa(focusHours.StartHour))
+	g.focusStartEntry.OnChanged = func(text string) { g.saveFocusHours() }
+	g.focusEndEntry = widget.NewEntry()
+	g.focusEndEntry.SetText(strconv.Itoa(focusHours.EndHour))
+	g.focusEndEntry.OnChanged = func(text string) { g.saveFocusHours() }
+
 	form := &widget.Form{
 		Items: []*widget.FormItem{
 			{Text: "Name", Widget: g.nameEntry},
 			{Text: "Minutes", Widget: g.minutesEntry},
 			{Text: "Hour Set", Widget: g.hourSetEntry},
+			{Text: "Interval mode", Widget: g.intervalCheck},
+			{Text: "Work (min)", Widget: g.workMinutes},
+			{Text: "Short break (min)", Widget: g.shortBreakMins},
+			{Text: "Long break (min)", Widget: g.longBreakMins},
+			{Text: "Cycles before long break", Widget: g.cyclesBeforeLong},
+			{Text: "Total cycles", Widget: g.totalCycles},
+			{Text: "End actions", Widget: g.endActionSelect},
+			{Text: "Custom command", Widget: g.customCmdEntry},
+			{Text: "Focus hours start", Widget: g.focusStartEntry},
+			{Text: "Focus hours end", Widget: g.focusEndEntry},
 		},
 	}
 
 	content := container.NewVBox(
 		form,
+		g.sessionLabel,
 		g.countdownLabel,
 		g.endTimeLabel,
 		container.NewHBox(g.startButton, g.stopButton),
 	)
 
-	g.window.SetContent(content)
-	g.window.Canvas().SetOnTypedKey(func(key *fyne.KeyEvent) {
-		if key.Name == fyne.KeyEscape {
-			g.window.Close()
-		}
-		if key.Name == fyne.KeyReturn {
-			g.startTimer()
-		}
-	})
+	g.historyList = widget.NewList(
+		func() int { return len(g.getHistoryRows()) },
+		func() fyne.CanvasObject { return widget.NewLabel("") },
+		func(i widget.ListItemID, o fyne.CanvasObject) {
+			rows := g.getHistoryRows()
+			if int(i) < len(rows) {
+				o.(*widget.Label).SetText(rows[i])
+			}
+		},
+	)
+	g.refreshHistory()
 
-	// Focus on any key press when window is active
-	g.window.Canvas().SetOnTypedRune(func(r rune) {
-		g.window.Canvas().Focus(g.minutesEntry)
-	})
+	tabs := container.NewAppTabs(
+		container.NewTabItem("Timer", content),
+		container.NewTabItem("History", g.historyList),
+	)
+
+	g.window.SetContent(tabs)
+	g.attachKeyboard()
+	registerGlobalHotkeys(g)
 
 	// Focus MINUTE field on window creation and close
 	g.window.Canvas().Focus(g.minutesEntry)
@@ -183,7 +369,71 @@ func (g *GUI) createUI() {
 	g.window.Show()
 }
 
+func (g *GUI) setPaused(paused bool) {
+	g.sessionMu.Lock()
+	defer g.sessionMu.Unlock()
+	g.paused = paused
+}
+
+func (g *GUI) isPaused() bool {
+	g.sessionMu.Lock()
+	defer g.sessionMu.Unlock()
+	return g.paused
+}
+
+func (g *GUI) setLastDuration(d time.Duration) {
+	g.sessionMu.Lock()
+	defer g.sessionMu.Unlock()
+	g.lastDuration = d
+}
+
+func (g *GUI) getLastDuration() time.Duration {
+	g.sessionMu.Lock()
+	defer g.sessionMu.Unlock()
+	return g.lastDuration
+}
+
+func (g *GUI) setCurrentStart(t time.Time) {
+	g.sessionMu.Lock()
+	defer g.sessionMu.Unlock()
+	g.currentStart = t
+}
+
+func (g *GUI) getCurrentStart() time.Time {
+	g.sessionMu.Lock()
+	defer g.sessionMu.Unlock()
+	return g.currentStart
+}
+
+// takeCurrentStart atomically reads currentStart and clears it, so that
+// when stopTimer and runTicker's completion branch race to record the
+// same session, only the one that sees the non-zero value wins.
+func (g *GUI) takeCurrentStart() time.Time {
+	g.sessionMu.Lock()
+	defer g.sessionMu.Unlock()
+	start := g.currentStart
+	g.currentStart = time.Time{}
+	return start
+}
+
+func (g *GUI) setTimerService(s TimerService) {
+	g.sessionMu.Lock()
+	defer g.sessionMu.Unlock()
+	g.timerService = s
+}
+
+func (g *GUI) getTimerService() TimerService {
+	g.sessionMu.Lock()
+	defer g.sessionMu.Unlock()
+	return g.timerService
+}
+
 func (g *GUI) startTimer() {
+	if g.intervalCheck.Checked {
+		g.startIntervalTimer()
+		return
+	}
+
 	minutesText := g.minutesEntry.Text
 	hourSetText := g.hourSetEntry.Text
 
@@ -205,55 +455,350 @@ func (g *GUI) startTimer() {
 		return
 	}
 
-	g.timerService = NewRealTimer(duration)
-	g.timerService.Start()
+	g.startCountdown(duration)
+}
+
+// startCountdown starts a plain RealTimer for duration, bypassing the
+// Pomodoro-mode branch in startTimer. Used directly by the keyboard
+// preset/restart commands (keyboard.go), which must start a fixed-length
+// countdown even while Interval mode is checked.
+func (g *GUI) startCountdown(duration time.Duration) {
+	if !atomic.CompareAndSwapInt32(&g.running, 0, 1) {
+		return // a timer is already running; ignore the re-entrant start
+	}
+
+	g.sessionLabel.Hide()
+	g.setCurrentStart(time.Now())
+	g.setPaused(false)
+	g.setLastDuration(duration)
+	service := NewRealTimer(duration)
+	g.setTimerService(service)
+	service.Start()
 	g.startButton.Disable()
 	g.stopButton.Enable()
 
+	g.runTicker()
+}
+
+// startIntervalTimer reads the Pomodoro form fields and starts an
+// IntervalTimer instead of a single countdown.
+func (g *GUI) startIntervalTimer() {
+	if !atomic.CompareAndSwapInt32(&g.running, 0, 1) {
+		return // a timer is already running; ignore the re-entrant start
+	}
+
+	work := entryMinutesOrDefault(g.workMinutes, 25)
+	shortBreak := entryMinutesOrDefault(g.shortBreakMins, 5)
+	longBreak := entryMinutesOrDefault(g.longBreakMins, 15)
+	cyclesBeforeLong := entryIntOrDefault(g.cyclesBeforeLong, 4)
+	total := entryIntOrDefault(g.totalCycles, 8)
+
+	interval := NewIntervalTimer(IntervalConfig{
+		WorkDuration:          work,
+		ShortBreak:            shortBreak,
+		LongBreak:             longBreak,
+		CyclesBeforeLongBreak: cyclesBeforeLong,
+		TotalCycles:           total,
+	})
+	interval.OnPhaseChange = g.onPhaseChange
+
+	g.setCurrentStart(time.Now())
+	g.setPaused(false)
+	g.setTimerService(interval)
+	interval.Start()
+	g.startButton.Disable()
+	g.stopButton.Enable()
+	g.onPhaseChange(interval.CurrentPhase())
+
+	g.runTicker()
+}
+
+// onPhaseChange updates the Session panel and colors it Work vs. Break.
+func (g *GUI) onPhaseChange(p PhaseChange) {
+	g.sessionLabel.Show()
+	g.sessionLabel.Text = fmt.Sprintf("Cycle %d/%d — %s", p.Cycle, p.TotalCycles, p.Phase)
+	if p.Phase == PhaseWork {
+		g.sessionLabel.Color = theme.Color(theme.ColorNameWarning)
+	} else {
+		g.sessionLabel.Color = theme.Color(theme.ColorNameSuccess)
+	}
+	g.sessionLabel.Refresh()
+}
+
+// runTicker polls the timer that was just started. It captures that
+// timer once so a later start doesn't hand this goroutine's select a
+// different, newer TimerService out from under it.
+func (g *GUI) runTicker() {
+	service := g.getTimerService()
 	go func() {
 		for {
 			select {
-			case <-g.timerService.(*RealTimer).done:
+			case <-service.Done():
 				return
 			case <-time.After(1 * time.Second):
-				remaining := g.timerService.GetRemainingTime()
+				remaining := service.GetRemainingTime()
 				if remaining <= 0 {
-					g.timerService.Stop()
+					service.Stop()
 					g.startButton.Enable()
 					g.stopButton.Disable()
 					g.countdownLabel.SetText("00:00:00")
 					g.endTimeLabel.SetText("End Time: --:--")
-					g.minimizeAllWindows()
+					g.sessionLabel.Hide()
+					g.recordSession()
+					g.writeState(false, 0, "")
+					atomic.StoreInt32(&g.running, 0)
+					g.runEndActions()
 					return
 				}
-				g.countdownLabel.SetText(fmt.Sprintf("%02d:%02d:%02d", int(remaining.Hours()), int(remaining.Minutes())%60, int(remaining.Seconds())%60))
-				g.endTimeLabel.SetText(fmt.Sprintf("End Time: %02d:%02d", g.timerService.GetEndTime().Hour(), g.timerService.GetEndTime().Minute()))
+				countdown := fmt.Sprintf("%02d:%02d:%02d", int(remaining.Hours()), int(remaining.Minutes())%60, int(remaining.Seconds())%60)
+				g.countdownLabel.SetText(countdown)
+				g.endTimeLabel.SetText(fmt.Sprintf("End Time: %02d:%02d", service.GetEndTime().Hour(), service.GetEndTime().Minute()))
+				g.writeState(true, remaining, countdown)
 			}
 		}
 	}()
 }
 
 func (g *GUI) stopTimer() {
-	g.timerService.Stop()
+	g.getTimerService().Stop()
 	g.startButton.Enable()
 	g.stopButton.Disable()
 	g.countdownLabel.SetText("00:00:00")
 	g.endTimeLabel.SetText("End Time: --:--")
+	g.sessionLabel.Hide()
+	g.setPaused(false)
+	g.recordSession()
+	g.writeState(false, 0, "")
+	atomic.StoreInt32(&g.running, 0)
 }
 
-func (g *GUI) minimizeAllWindows() {
-	cmd := exec.Command("powershell", "-Command", "(New-Object -ComObject Shell.Application).MinimizeAll()")
-	err := cmd.Run()
+// writeState publishes the current timer state to statePath so a
+// separate `TimeKiller status` process can report it to a status bar.
+func (g *GUI) writeState(running bool, remaining time.Duration, text string) {
+	if g.statePath == "" {
+		return
+	}
+	state := TimerStatus{
+		Running:          running,
+		RemainingSeconds: int64(remaining.Seconds()),
+		Text:             text,
+		UpdatedAt:        time.Now(),
+	}
+	if err := WriteStatusState(g.statePath, state); err != nil {
+		fmt.Println("Error writing status state:", err)
+	}
+}
+
+// recordSession appends the just-finished (or just-stopped) session to
+// the TimerStore and refreshes the History tab.
+func (g *GUI) recordSession() {
+	start := g.takeCurrentStart()
+	if g.store == nil || start.IsZero() {
+		return
+	}
+	name := g.nameEntry.Text
+	if name == "" {
+		name = "Unnamed"
+	}
+	entry := NewTimerEntry(start, time.Now(), name)
+	if err := g.store.Append(entry); err != nil {
+		fmt.Println("Error saving timer entry:", err)
+		return
+	}
+	g.refreshHistory()
+}
+
+// getHistoryRows returns the History tab's current rows. See the
+// historyMu field comment for why this isn't a plain field read.
+func (g *GUI) getHistoryRows() []string {
+	g.historyMu.Lock()
+	defer g.historyMu.Unlock()
+	return g.historyRows
+}
+
+func (g *GUI) setHistoryRows(rows []string) {
+	g.historyMu.Lock()
+	defer g.historyMu.Unlock()
+	g.historyRows = rows
+}
+
+// refreshHistory rebuilds the History tab's rows: today's total (via
+// TimerStore.Filter), a per-day total line followed by each day's
+// individual sessions (most recent day first), and finally a breakdown
+// by the @context/+project tags parsed out of each session's name. The
+// rows are built into a local slice and only published via
+// setHistoryRows once complete, since recordSession can call this from
+// runTicker's background goroutine while the widget.List reads
+// historyRows on the UI thread.
+func (g *GUI) refreshHistory() {
+	if g.store == nil {
+		g.setHistoryRows(nil)
+		return
+	}
+	entries, err := g.store.LoadAll()
 	if err != nil {
-		fmt.Println("Error minimizing windows:", err)
+		fmt.Println("Error loading timer history:", err)
+		return
+	}
+
+	var rows []string
+
+	today := time.Now().Format("2006-01-02")
+	todayEntries, err := g.store.Filter(func(e TimerEntry) bool {
+		return e.Start.Format("2006-01-02") == today
+	})
+	if err != nil {
+		fmt.Println("Error filtering today's timer history:", err)
+	} else {
+		var todayTotal time.Duration
+		for _, e := range todayEntries {
+			todayTotal += roundDuration(e.Duration(), historyRounding)
+		}
+		rows = append(rows, fmt.Sprintf("Today — total %s", todayTotal))
+	}
+
+	totals := DailyTotals(entries, historyRounding)
+	byDay := make(map[string][]TimerEntry)
+	for _, e := range entries {
+		day := e.Start.Format("2006-01-02")
+		byDay[day] = append(byDay[day], e)
+	}
+
+	days := make([]string, 0, len(byDay))
+	for day := range byDay {
+		days = append(days, day)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(days)))
+
+	for _, day := range days {
+		rows = append(rows, fmt.Sprintf("%s — total %s", day, totals[day]))
+		for _, e := range byDay[day] {
+			rows = append(rows, fmt.Sprintf("  %s-%s  %s (%s)",
+				e.Start.Format("15:04"), e.End.Format("15:04"), e.Name, roundDuration(e.Duration(), historyRounding)))
+		}
+	}
+
+	appendTagTotals(&rows, "By project", ProjectTotals(entries, historyRounding))
+	appendTagTotals(&rows, "By context", ContextTotals(entries, historyRounding))
+
+	g.setHistoryRows(rows)
+
+	if g.historyList != nil {
+		g.historyList.Refresh()
+	}
+}
+
+// appendTagTotals renders a @context/+project breakdown section, sorted
+// by descending total so the biggest buckets sort to the top.
+func appendTagTotals(rows *[]string, heading string, totals map[string]time.Duration) {
+	if len(totals) == 0 {
+		return
+	}
+	tags := make([]string, 0, len(totals))
+	for tag := range totals {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool { return totals[tags[i]] > totals[tags[j]] })
+
+	*rows = append(*rows, heading+":")
+	for _, tag := range tags {
+		*rows = append(*rows, fmt.Sprintf("  %s — %s", tag, totals[tag]))
+	}
+}
+
+// saveFocusHours persists the Focus hours fields to config.json so the
+// headless `TimeKiller status` process picks up the same window.
+func (g *GUI) saveFocusHours() {
+	start, err1 := strconv.Atoi(g.focusStartEntry.Text)
+	end, err2 := strconv.Atoi(g.focusEndEntry.Text)
+	if err1 != nil || err2 != nil || start < 0 || start > 23 || end < 0 || end > 23 {
+		return
+	}
+	if err := SaveFocusHours(FocusHoursConfig{StartHour: start, EndHour: end}); err != nil {
+		fmt.Println("Error saving focus hours:", err)
+	}
+}
+
+func entryMinutesOrDefault(entry *widget.Entry, fallback float64) time.Duration {
+	if entry.Text == "" {
+		return time.Duration(fallback * float64(time.Minute))
+	}
+	minutes, err := strconv.ParseFloat(entry.Text, 64)
+	if err != nil || minutes <= 0 {
+		return time.Duration(fallback * float64(time.Minute))
+	}
+	return time.Duration(minutes * float64(time.Minute))
+}
+
+func entryIntOrDefault(entry *widget.Entry, fallback int) int {
+	if entry.Text == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(entry.Text)
+	if err != nil || n <= 0 {
+		return fallback
+	}
+	return n
+}
+
+// runEndActions runs every end action the user selected in the form,
+// plus the custom command if one is configured, logging (but not
+// aborting on) individual failures so one broken action doesn't mask
+// the others.
+func (g *GUI) runEndActions() {
+	for _, name := range g.endActionSelect.Selected {
+		action, ok := ResolveEndAction(name)
+		if !ok && name == "Notify" {
+			action = NotifyAction{
+				App:     g.app,
+				Title:   "TimeKiller",
+				Content: fmt.Sprintf("%s finished", g.nameEntry.Text),
+			}
+			ok = true
+		}
+		if !ok {
+			continue
+		}
+		if err := action.Run(); err != nil {
+			fmt.Println("Error running end action", name, ":", err)
+		}
+	}
+
+	if cmd := g.customCmdEntry.Text; cmd != "" {
+		if err := (RunCommandAction{Cmd: cmd}).Run(); err != nil {
+			fmt.Println("Error running custom command:", err)
+		}
 	}
 }
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "status", "i3status":
+			runStatusCLI()
+			return
+		}
+	}
+
 	app := app.New()
 	app.Settings().SetTheme(theme.DarkTheme())
 
-	gui := NewGUI(app, NewRealTimer(0))
+	var store TimerStore
+	storePath, err := DefaultTimerStorePath()
+	if err != nil {
+		fmt.Println("Error locating timer store:", err)
+	} else if fs, ferr := NewFileTimerStore(storePath); ferr != nil {
+		fmt.Println("Error opening timer store:", ferr)
+	} else {
+		store = fs
+	}
+
+	statePath, err := StatusStatePath()
+	if err != nil {
+		fmt.Println("Error locating status state:", err)
+	}
+
+	gui := NewGUI(app, NewRealTimer(0), store, statePath)
 	gui.createUI()
 
 	app.Run()
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// TimerStatus is the shared state the GUI process writes on every tick
+// so a separate, headless CLI process (see cli.go) can report the
+// running timer to a status bar without talking to the GUI directly.
+type TimerStatus struct {
+	Running          bool      `json:"running"`
+	RemainingSeconds int64     `json:"remaining_seconds"`
+	Text             string    `json:"text"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// StatusStatePath returns ~/.config/timekiller/state.json (or the
+// platform equivalent of the user config dir).
+func StatusStatePath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("find user config dir: %w", err)
+	}
+	return filepath.Join(configDir, "timekiller", "state.json"), nil
+}
+
+// WriteStatusState overwrites path with state as JSON, creating the
+// parent directory if necessary.
+func WriteStatusState(path string, state TimerStatus) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create state dir: %w", err)
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("marshal state: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+	return nil
+}
+
+// ReadStatusState reads and parses the state file written by the GUI
+// process.
+func ReadStatusState(path string) (TimerStatus, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return TimerStatus{}, fmt.Errorf("read state: %w", err)
+	}
+	var state TimerStatus
+	if err := json.Unmarshal(data, &state); err != nil {
+		return TimerStatus{}, fmt.Errorf("parse state: %w", err)
+	}
+	return state, nil
+}
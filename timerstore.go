@@ -0,0 +1,210 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// timeLogLayout is the timestamp format used by the timer.txt ecosystem
+// (https://github.com/timetrace-go/timetrace and friends): RFC3339 with
+// no zone offset, fixed-width so entries sort lexically in time order.
+// Times are written as local wall-clock time and parsed back as UTC.
+const timeLogLayout = "2006-01-02T15:04:05"
+
+// TimerEntry is one finished (or stopped) timer session.
+type TimerEntry struct {
+	Start    time.Time
+	End      time.Time
+	Name     string
+	Contexts []string // parsed from @context tokens in Name
+	Projects []string // parsed from +project tokens in Name
+}
+
+// Duration is how long the session actually ran.
+func (e TimerEntry) Duration() time.Duration {
+	return e.End.Sub(e.Start)
+}
+
+// parseTags pulls @context and +project tokens out of a timer name,
+// timer.txt-style, without removing them from the displayed Name.
+func parseTags(name string) (contexts, projects []string) {
+	for _, field := range strings.Fields(name) {
+		switch {
+		case strings.HasPrefix(field, "@") && len(field) > 1:
+			contexts = append(contexts, field[1:])
+		case strings.HasPrefix(field, "+") && len(field) > 1:
+			projects = append(projects, field[1:])
+		}
+	}
+	return contexts, projects
+}
+
+// NewTimerEntry builds a TimerEntry for a completed session, parsing
+// @context/+project tags out of name.
+func NewTimerEntry(start, end time.Time, name string) TimerEntry {
+	contexts, projects := parseTags(name)
+	return TimerEntry{Start: start, End: end, Name: name, Contexts: contexts, Projects: projects}
+}
+
+// TimerStore persists finished timer sessions and makes them queryable.
+type TimerStore interface {
+	Append(entry TimerEntry) error
+	LoadAll() ([]TimerEntry, error)
+	Filter(pred func(TimerEntry) bool) ([]TimerEntry, error)
+}
+
+// FileTimerStore is a TimerStore backed by a plain-text, timer.txt-format
+// log file: one line per entry, "<start> - <end> <name>".
+type FileTimerStore struct {
+	path string
+}
+
+// NewFileTimerStore returns a FileTimerStore backed by path, creating
+// its parent directory if necessary.
+func NewFileTimerStore(path string) (*FileTimerStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("create timer store dir: %w", err)
+	}
+	return &FileTimerStore{path: path}, nil
+}
+
+// DefaultTimerStorePath returns ~/.config/timekiller/timer.txt (or the
+// platform equivalent of the user config dir).
+func DefaultTimerStorePath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("find user config dir: %w", err)
+	}
+	return filepath.Join(configDir, "timekiller", "timer.txt"), nil
+}
+
+func (s *FileTimerStore) Append(entry TimerEntry) error {
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("open timer store: %w", err)
+	}
+	defer f.Close()
+
+	line := fmt.Sprintf("%s - %s %s\n",
+		entry.Start.Format(timeLogLayout),
+		entry.End.Format(timeLogLayout),
+		entry.Name,
+	)
+	if _, err := f.WriteString(line); err != nil {
+		return fmt.Errorf("write timer entry: %w", err)
+	}
+	return nil
+}
+
+func (s *FileTimerStore) LoadAll() ([]TimerEntry, error) {
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open timer store: %w", err)
+	}
+	defer f.Close()
+
+	var entries []TimerEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		entry, err := parseTimerLine(line)
+		if err != nil {
+			continue // skip malformed/foreign lines rather than fail the whole load
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read timer store: %w", err)
+	}
+	return entries, nil
+}
+
+func (s *FileTimerStore) Filter(pred func(TimerEntry) bool) ([]TimerEntry, error) {
+	all, err := s.LoadAll()
+	if err != nil {
+		return nil, err
+	}
+	var filtered []TimerEntry
+	for _, e := range all {
+		if pred(e) {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered, nil
+}
+
+func parseTimerLine(line string) (TimerEntry, error) {
+	parts := strings.SplitN(line, " - ", 2)
+	if len(parts) != 2 {
+		return TimerEntry{}, fmt.Errorf("malformed timer line: %q", line)
+	}
+	start, err := time.Parse(timeLogLayout, parts[0])
+	if err != nil {
+		return TimerEntry{}, fmt.Errorf("parse start time: %w", err)
+	}
+	rest := strings.SplitN(parts[1], " ", 2)
+	if len(rest) != 2 {
+		return TimerEntry{}, fmt.Errorf("malformed timer line: %q", line)
+	}
+	end, err := time.Parse(timeLogLayout, rest[0])
+	if err != nil {
+		return TimerEntry{}, fmt.Errorf("parse end time: %w", err)
+	}
+	return NewTimerEntry(start, end, rest[1]), nil
+}
+
+// roundDuration rounds d to the nearest multiple of interval, the way
+// time-tracking tools round sessions for billing/reporting.
+func roundDuration(d, interval time.Duration) time.Duration {
+	if interval <= 0 {
+		return d
+	}
+	return (d + interval/2) / interval * interval
+}
+
+// DailyTotals buckets entries by calendar day and sums their (rounded)
+// durations.
+func DailyTotals(entries []TimerEntry, rounding time.Duration) map[string]time.Duration {
+	totals := make(map[string]time.Duration)
+	for _, e := range entries {
+		day := e.Start.Format("2006-01-02")
+		totals[day] += roundDuration(e.Duration(), rounding)
+	}
+	return totals
+}
+
+// ProjectTotals buckets entries by their +project tags and sums their
+// (rounded) durations. An entry tagged with more than one project
+// contributes its full duration to each.
+func ProjectTotals(entries []TimerEntry, rounding time.Duration) map[string]time.Duration {
+	totals := make(map[string]time.Duration)
+	for _, e := range entries {
+		for _, project := range e.Projects {
+			totals[project] += roundDuration(e.Duration(), rounding)
+		}
+	}
+	return totals
+}
+
+// ContextTotals buckets entries by their @context tags and sums their
+// (rounded) durations. An entry tagged with more than one context
+// contributes its full duration to each.
+func ContextTotals(entries []TimerEntry, rounding time.Duration) map[string]time.Duration {
+	totals := make(map[string]time.Duration)
+	for _, e := range entries {
+		for _, context := range e.Contexts {
+			totals[context] += roundDuration(e.Duration(), rounding)
+		}
+	}
+	return totals
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTags(t *testing.T) {
+	contexts, projects := parseTags("Write report @work @focus +timekiller")
+	if len(contexts) != 2 || contexts[0] != "work" || contexts[1] != "focus" {
+		t.Errorf("contexts = %v, want [work focus]", contexts)
+	}
+	if len(projects) != 1 || projects[0] != "timekiller" {
+		t.Errorf("projects = %v, want [timekiller]", projects)
+	}
+}
+
+func TestParseTagsNoTags(t *testing.T) {
+	contexts, projects := parseTags("Just a plain name")
+	if contexts != nil || projects != nil {
+		t.Errorf("got contexts=%v projects=%v, want both nil", contexts, projects)
+	}
+}
+
+func TestRoundDuration(t *testing.T) {
+	cases := []struct {
+		d, interval, want time.Duration
+	}{
+		{7 * time.Minute, 15 * time.Minute, 0},
+		{8 * time.Minute, 15 * time.Minute, 15 * time.Minute},
+		{23 * time.Minute, 15 * time.Minute, 30 * time.Minute},
+		{53 * time.Minute, 15 * time.Minute, time.Hour},
+		{90 * time.Second, 0, 90 * time.Second}, // no rounding configured
+	}
+	for _, c := range cases {
+		if got := roundDuration(c.d, c.interval); got != c.want {
+			t.Errorf("roundDuration(%v, %v) = %v, want %v", c.d, c.interval, got, c.want)
+		}
+	}
+}
+
+func TestDailyTotals(t *testing.T) {
+	day1 := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	day2 := time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)
+	entries := []TimerEntry{
+		NewTimerEntry(day1, day1.Add(25*time.Minute), "Work @office"),
+		NewTimerEntry(day1.Add(time.Hour), day1.Add(time.Hour+20*time.Minute), "More work @office"),
+		NewTimerEntry(day2, day2.Add(40*time.Minute), "Other day"),
+	}
+
+	totals := DailyTotals(entries, 15*time.Minute)
+	if totals["2026-01-01"] != 45*time.Minute {
+		t.Errorf("2026-01-01 total = %v, want 45m", totals["2026-01-01"])
+	}
+	if totals["2026-01-02"] != 45*time.Minute {
+		t.Errorf("2026-01-02 total = %v, want 45m", totals["2026-01-02"])
+	}
+}
+
+func TestProjectAndContextTotals(t *testing.T) {
+	start := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	entries := []TimerEntry{
+		NewTimerEntry(start, start.Add(30*time.Minute), "Fix bug @office +timekiller"),
+		NewTimerEntry(start, start.Add(30*time.Minute), "Write docs @home +timekiller"),
+	}
+
+	projects := ProjectTotals(entries, 0)
+	if projects["timekiller"] != time.Hour {
+		t.Errorf("project total = %v, want 1h", projects["timekiller"])
+	}
+
+	contexts := ContextTotals(entries, 0)
+	if contexts["office"] != 30*time.Minute || contexts["home"] != 30*time.Minute {
+		t.Errorf("context totals = %v, want office=30m home=30m", contexts)
+	}
+}